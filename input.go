@@ -0,0 +1,90 @@
+package main
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+// Input is factored out of Game.Update so keyboard, gamepad, and (eventually)
+// touch all feed the same three questions: which way, fire, start/advance.
+
+const gamepadDeadzone = 0.15
+
+// startButtons are tried in order across controller layouts/platforms —
+// "Start" shows up as Center Right on most pads, Center Left on a few.
+var startButtons = []ebiten.StandardGamepadButton{
+	ebiten.StandardGamepadButtonCenterRight,
+	ebiten.StandardGamepadButtonCenterLeft,
+}
+
+// standardGamepadIDs returns connected gamepad IDs that speak the standard
+// layout; non-standard pads are skipped rather than guessing their mapping.
+func standardGamepadIDs() []ebiten.GamepadID {
+	var ids []ebiten.GamepadID
+	for _, id := range ebiten.AppendGamepadIDs(nil) {
+		if ebiten.IsStandardGamepadLayoutAvailable(id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func axisWithDeadzone(id ebiten.GamepadID, axis ebiten.StandardGamepadAxis) float64 {
+	v := ebiten.StandardGamepadAxisValue(id, axis)
+	if v > -gamepadDeadzone && v < gamepadDeadzone {
+		return 0
+	}
+	return v
+}
+
+// moveVec reports the desired movement direction from keyboard and/or
+// gamepad left stick, each axis clamped to [-1, 1].
+func moveVec() (dx, dy float64) {
+	if ebiten.IsKeyPressed(ebiten.KeyLeft) || ebiten.IsKeyPressed(ebiten.KeyA) {
+		dx -= 1
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyRight) || ebiten.IsKeyPressed(ebiten.KeyD) {
+		dx += 1
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyUp) || ebiten.IsKeyPressed(ebiten.KeyW) {
+		dy -= 1
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyDown) || ebiten.IsKeyPressed(ebiten.KeyS) {
+		dy += 1
+	}
+
+	for _, id := range standardGamepadIDs() {
+		dx += axisWithDeadzone(id, ebiten.StandardGamepadAxisLeftStickHorizontal)
+		dy += axisWithDeadzone(id, ebiten.StandardGamepadAxisLeftStickVertical)
+	}
+
+	dx = clampAbs(dx, 0, 1)
+	dy = clampAbs(dy, 0, 1)
+	return dx, dy
+}
+
+// firePressed reports whether the shoot action is held this frame.
+func firePressed() bool {
+	if ebiten.IsKeyPressed(ebiten.KeySpace) || ebiten.IsKeyPressed(ebiten.KeyJ) {
+		return true
+	}
+	for _, id := range standardGamepadIDs() {
+		if ebiten.IsStandardGamepadButtonPressed(id, ebiten.StandardGamepadButtonRightBottom) {
+			return true
+		}
+	}
+	return false
+}
+
+// startPressed reports whether the title/win/game-over "advance" action is
+// held this frame.
+func startPressed() bool {
+	if ebiten.IsKeyPressed(ebiten.KeyEnter) || ebiten.IsKeyPressed(ebiten.KeySpace) {
+		return true
+	}
+	for _, id := range standardGamepadIDs() {
+		for _, b := range startButtons {
+			if ebiten.IsStandardGamepadButtonPressed(id, b) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -6,14 +6,21 @@ package main
 
 import (
 	"bytes"
+	"embed"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"image"
 	"image/color"
 	_ "image/jpeg" // register .jpg decoder so ebiten can load jpg
 	_ "image/png"  // register .png decoder so ebiten can load png
+	"io"
+	"io/fs"
 	"log"
 	"math"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -22,9 +29,126 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/audio"
 	"github.com/hajimehoshi/ebiten/v2/audio/wav"
 
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
 	"github.com/solarlune/resolv"
 )
 
+//go:embed assets/*
+var embeddedAssets embed.FS
+
+// -assets lets modders point at a real directory on disk instead of the
+// assets baked into the binary at build time.
+var assetsDir = flag.String("assets", "", "path to an assets directory on disk (overrides the embedded assets)")
+
+// openAssets returns the FS to load sprites/audio from: the embedded assets
+// by default, or -assets on disk when a modder sets it.
+func openAssets() fs.FS {
+	if *assetsDir != "" {
+		return os.DirFS(*assetsDir)
+	}
+	sub, err := fs.Sub(embeddedAssets, "assets")
+	if err != nil {
+		log.Fatalf("embedded assets: %v", err)
+	}
+	return sub
+}
+
+// LoadImage decodes an image at path from fsys (embedded assets or -assets disk dir).
+func LoadImage(fsys fs.FS, path string) (*ebiten.Image, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+	return ebiten.NewImageFromImage(img), nil
+}
+
+// LoadWAV decodes a WAV at path from fsys and returns a ready-to-play Player.
+func LoadWAV(ctx *audio.Context, fsys fs.FS, path string) (*audio.Player, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	rsc, ok := f.(readSeekCloser)
+	if !ok {
+		b, err := io.ReadAll(f)
+		if err != nil {
+			return nil, err
+		}
+		rsc = &readSeekNopCloser{bytes.NewReader(b)}
+	}
+	s, err := wav.DecodeWithoutResampling(rsc)
+	if err != nil {
+		return nil, err
+	}
+	return audio.NewPlayer(ctx, s)
+}
+
+// readSeekCloser is what wav.DecodeWithoutResampling needs; the fs.File
+// interface doesn't guarantee Seek, so LoadWAV falls back to buffering into
+// memory for FS implementations that don't provide it.
+type readSeekCloser interface {
+	io.Reader
+	io.Seeker
+	io.Closer
+}
+
+// === HIGH SCORE PERSISTENCE ===
+
+const highScoreDirName = "topscroller"
+const highScoreFileName = "highscore.json"
+
+type highScoreFile struct {
+	HighScore int `json:"high_score"`
+}
+
+func highScorePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, highScoreDirName, highScoreFileName), nil
+}
+
+// loadHighScore returns the persisted high score, or 0 if none is found.
+func loadHighScore() int {
+	path, err := highScorePath()
+	if err != nil {
+		return 0
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	var f highScoreFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return 0
+	}
+	return f.HighScore
+}
+
+func saveHighScore(score int) error {
+	path, err := highScorePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(highScoreFile{HighScore: score})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
 // === BASIC SETTINGS (constants) ===
 // window is tall → vertical scroller feel
 const (
@@ -44,6 +168,26 @@ const (
 	startLives        = 2    // 2 hits allowed, 3rd = game over
 	iframeTicks       = 60   // brief invuln after hit (prevents multi-hit)
 
+	// enemy steering
+	seekDistance     = 200.0 // px: player must be this close before an enemy seeks
+	driftJitter      = 0.4   // px/frame: random x wobble while drifting
+	minSpeedFactor   = 0.6   // fraction of enemySpeed(round) an enemy can slow to
+	maxSpeedFactor   = 1.6   // fraction of enemySpeed(round) an enemy can speed up to
+	nextActionMin    = 20    // frames before an enemy reconsiders its steering
+	nextActionJitter = 20    // + random frames on top of nextActionMin
+
+	// enemy archetypes
+	batSpeedMultiplier = 1.6  // bats outrun zombies
+	batSinAmplitude    = 1.5  // px/frame: bat x-drift wobble
+	batSinFreq         = 0.15 // radians/frame: bat x-drift wobble
+	zombieHealth       = 1
+	zombieScore        = 10
+	batHealth          = 1
+	batScore           = 20
+	bossHealth         = 12
+	bossScore          = 250
+	roundClearBonus    = 100 // score bonus awarded on clearing a round
+
 	// win/lose panel dims
 	panelW = 320
 	panelH = 120
@@ -53,15 +197,77 @@ const (
 
 	// audio sample rate
 	sampleRate = 44100
+
+	// powerups
+	powerupSize          = 28.0
+	powerupSpeed         = 1.5
+	powerupSpawnInterval = 420 // frames between pickup drops
+	repelRadius          = 110.0
+	repelDuration        = 6 * time.Second
+	freezeDuration       = 3 * time.Second
+
+	// particles (muzzle flash, blood spray, hit spark)
+	maxParticles    = 512 // ring-buffer pool size, reused instead of reallocated
+	muzzleParticles = 6
+	muzzleLife      = 10
+	muzzleSpeed     = 2.0
+	gibParticles    = 8
+	gibLife         = 20
+	gibSpeed        = 2.5
+	hitParticles    = 8
+	hitLife         = 18
+	hitSpeed        = 2.0
 )
 
-// 6 rounds total. clear last = win.
-var rounds = []int{6, 12, 18, 24, 30, 42} // kills needed per round
+// enemy archetypes
+type enemyKind int
+
+const (
+	TypeZombie enemyKind = iota
+	TypeBat
+	TypeBoss
+)
+
+// spawnEntry is one archetype's quota within a round.
+type spawnEntry struct {
+	kind  enemyKind
+	count int
+}
+
+// 6 rounds total. clear last = win. each round is a mix of archetypes.
+var rounds = [][]spawnEntry{
+	{{TypeZombie, 6}},
+	{{TypeZombie, 10}, {TypeBat, 2}},
+	{{TypeZombie, 14}, {TypeBat, 4}},
+	{{TypeZombie, 18}, {TypeBat, 6}},
+	{{TypeZombie, 20}, {TypeBat, 8}, {TypeBoss, 1}},
+	{{TypeZombie, 30}, {TypeBat, 10}, {TypeBoss, 2}},
+}
 
 // tags → filter collisions by type
 var (
 	tagEnemy  = resolv.NewTag("enemy")
 	tagBullet = resolv.NewTag("bullet")
+	tagPickup = resolv.NewTag("pickup")
+)
+
+// powerup kinds, modeled after the classic garlic (repel) and holy water
+// (screen freeze) pickups
+type powerupKind int
+
+const (
+	PowerupGarlic powerupKind = iota
+	PowerupHolyWater
+)
+
+// top-level game scenes
+type sceneID int
+
+const (
+	sceneTitle sceneID = iota
+	scenePlaying
+	sceneWin
+	sceneGameOver
 )
 
 // === DATA MODELS ===
@@ -72,10 +278,62 @@ type bullet struct {
 	sh   resolv.IShape //  bullet collision box
 }
 
+// enemy steering state
+type enemyState int
+
+const (
+	stateDrift enemyState = iota // no target in range, drifts down with jitter
+	stateSeek                    // player in seekDistance, steers toward them
+)
+
 type enemy struct {
+	x, y       float64
+	vx, vy     float64
+	state      enemyState
+	nextAction int // frames until AI reconsiders its steering
+	age        int // frames alive, drives the bat's sinusoidal drift
+
+	kind      enemyKind
+	health    int
+	killScore int
+
+	sh resolv.IShape //  Enemy collision box
+}
+
+// newEnemy builds an enemy of the given archetype at (x, y). The caller is
+// responsible for adding e.sh to the Game's space, matching how bullets and
+// powerups are spawned.
+func newEnemy(kind enemyKind, x, y float64) enemy {
+	e := enemy{x: x, y: y, kind: kind}
+	switch kind {
+	case TypeBat:
+		e.health, e.killScore = batHealth, batScore
+	case TypeBoss:
+		e.health, e.killScore = bossHealth, bossScore
+	default:
+		e.health, e.killScore = zombieHealth, zombieScore
+	}
+
+	sh := resolv.NewRectangleFromTopLeft(x, y, enemySize, enemySize)
+	sh.Tags().Set(tagEnemy)
+	e.sh = sh
+	return e
+}
+
+type powerup struct {
 	x, y float64
-	vy   float64
-	sh   resolv.IShape //  Enemy collision box
+	kind powerupKind
+	sh   resolv.IShape // pickup collision box
+}
+
+// particle is a short-lived visual effect (muzzle flash, blood spray, hit
+// spark). No collision shape — purely cosmetic, drawn as a fading rect.
+type particle struct {
+	x, y    float64
+	vx, vy  float64
+	life    float64
+	maxLife float64
+	col     color.RGBA
 }
 
 // full game state
@@ -85,25 +343,38 @@ type Game struct {
 	playerSh resolv.IShape
 
 	// world
-	bullets []bullet
-	enemies []enemy
-	space   *resolv.Space // collision grid
+	bullets   []bullet
+	enemies   []enemy
+	powerups  []powerup
+	particles []particle    // fixed-size ring buffer, see emitParticles
+	particleW int           // next write index into particles
+	space     *resolv.Space // collision grid
 
 	// round progress
-	roundIdx     int
-	roundSpawned int
-	roundKills   int
-	totalKills   int
+	roundIdx       int
+	roundSpawned   int
+	roundKills     int
+	totalKills     int
+	roundSpawnList []enemyKind // flattened per-round spawn order, built at round start
 
 	// timers
-	cooldown   int // shot delay
-	spawnTimer int // enemy spawn cadence
+	cooldown     int // shot delay
+	spawnTimer   int // enemy spawn cadence
+	powerupTimer int // pickup drop cadence
+
+	// active pickup effects
+	repelUntil  time.Time // enemies in range flee while now is before this
+	freezeUntil time.Time // enemy motion halted while now is before this
 
 	// end state
 	lives int
-	inv   int  // i-frames
-	win   bool // all rounds cleared
-	over  bool // out of lives
+	inv   int // i-frames
+	scene sceneID
+
+	// scoring
+	score      int
+	highScore  int
+	msgPrinter *message.Printer // English thousand-separated score formatting
 
 	// audio
 	audioCtx *audio.Context
@@ -113,12 +384,17 @@ type Game struct {
 	// optional sprites (nil → draw rects)
 	playerImg *ebiten.Image
 	zombieImg *ebiten.Image
+	batImg    *ebiten.Image
+	bossImg   *ebiten.Image
 	bulletImg *ebiten.Image
 
 	// background + scroll
 	bgImg *ebiten.Image
 	bgOff float64
 
+	// embedded by default, -assets dir on disk for modders
+	assets fs.FS
+
 	rng *rand.Rand
 }
 
@@ -142,11 +418,62 @@ func fireDelay(r int) int {
 	return d
 }
 
+// buildSpawnList flattens a round's archetype mix into one spawn order.
+func buildSpawnList(entries []spawnEntry) []enemyKind {
+	var list []enemyKind
+	for _, e := range entries {
+		for i := 0; i < e.count; i++ {
+			list = append(list, e.kind)
+		}
+	}
+	return list
+}
+
+func dist(x1, y1, x2, y2 float64) float64 {
+	dx, dy := x2-x1, y2-y1
+	return math.Sqrt(dx*dx + dy*dy)
+}
+
+// clampAbs keeps v's sign but bounds its magnitude to [min, max].
+func clampAbs(v, min, max float64) float64 {
+	sign := 1.0
+	if v < 0 {
+		sign = -1.0
+	}
+	av := math.Abs(v)
+	if av < min {
+		av = min
+	}
+	if av > max {
+		av = max
+	}
+	return sign * av
+}
+
 // === NEW GAME SETUP ===
 
 func newGame() *Game {
 	g := &Game{rng: rand.New(rand.NewSource(time.Now().UnixNano()))}
 
+	// assets: embedded by default, -assets dir on disk for modders
+	// (fallbacks still keep it runnable without either)
+	g.assets = openAssets()
+	g.initAudio()
+	g.initImages()
+
+	g.highScore = loadHighScore()
+	g.msgPrinter = message.NewPrinter(language.English)
+
+	g.scene = sceneTitle
+	g.resetRound()
+
+	return g
+}
+
+// resetRound puts the world back to the start of round 1: fresh player,
+// empty bullets/enemies/powerups, and a zeroed score. Used both for the
+// very first game and for restarting from the win/game-over screens.
+func (g *Game) resetRound() {
 	// spawns player near bottom center (coords = top-left)
 	g.px = screenW/2 - playerSize/2
 	g.py = screenH - 2*playerSize
@@ -156,16 +483,31 @@ func newGame() *Game {
 	g.playerSh = resolv.NewRectangleFromTopLeft(g.px, g.py, playerSize, playerSize)
 	g.space.Add(g.playerSh)
 
+	g.bullets, g.enemies, g.powerups = nil, nil, nil
+	g.particles = make([]particle, maxParticles)
+	g.particleW = 0
+
 	// round + health init
 	g.roundIdx, g.roundSpawned, g.roundKills, g.totalKills = 0, 0, 0, 0
-	g.lives, g.inv, g.win, g.over = startLives, 0, false, false
+	g.roundSpawnList = buildSpawnList(rounds[0])
+	g.lives, g.inv = startLives, 0
 	g.spawnTimer = spawnInterval(0)
+	g.powerupTimer = powerupSpawnInterval
+	g.repelUntil, g.freezeUntil = time.Time{}, time.Time{}
 
-	// assets (fallbacks keep it runnable without files)
-	g.initAudio()
-	g.initImages()
+	g.score = 0
+	g.bgOff = 0
+}
 
-	return g
+// endRun moves the game to a terminal scene and persists a new high score.
+func (g *Game) endRun(scene sceneID) {
+	g.scene = scene
+	if g.score > g.highScore {
+		g.highScore = g.score
+		if err := saveHighScore(g.highScore); err != nil {
+			log.Println("could not save high score:", err)
+		}
+	}
 }
 
 // === AUDIO ===
@@ -173,31 +515,18 @@ func newGame() *Game {
 func (g *Game) initAudio() {
 	g.audioCtx = audio.NewContext(sampleRate)
 
-	if p, err := loadWav(g.audioCtx, "assets/shoot.wav"); err == nil {
+	if p, err := LoadWAV(g.audioCtx, g.assets, "shoot.wav"); err == nil {
 		g.sShoot = p
 	} else {
 		g.sShoot = newBeep(g.audioCtx, 950, 0.07)
 	}
-	if p, err := loadWav(g.audioCtx, "assets/hit.wav"); err == nil {
+	if p, err := LoadWAV(g.audioCtx, g.assets, "hit.wav"); err == nil {
 		g.sHit = p
 	} else {
 		g.sHit = newBeep(g.audioCtx, 240, 0.12)
 	}
 }
 
-func loadWav(ctx *audio.Context, path string) (*audio.Player, error) {
-	f, err := os.Open(path)
-	if err != nil {
-		return nil, err
-	}
-	defer f.Close()
-	s, err := wav.DecodeWithoutResampling(f)
-	if err != nil {
-		return nil, err
-	}
-	return audio.NewPlayer(ctx, s)
-}
-
 type readSeekNopCloser struct{ *bytes.Reader }
 
 func (r *readSeekNopCloser) Close() error { return nil }
@@ -231,10 +560,10 @@ func (g *Game) play(p *audio.Player) {
 func (g *Game) initImages() {
 	// background: try a few common names
 	for _, name := range []string{
-		"assets/background.png", "assets/space.png",
-		"assets/background.jpg", "assets/space.jpg",
+		"background.png", "space.png",
+		"background.jpg", "space.jpg",
 	} {
-		if img, _, err := ebitenutil.NewImageFromFile(name); err == nil {
+		if img, err := LoadImage(g.assets, name); err == nil {
 			g.bgImg = img
 			break
 		}
@@ -244,19 +573,25 @@ func (g *Game) initImages() {
 	}
 
 	// optional sprites (nil → draw rects)
-	if img, _, err := ebitenutil.NewImageFromFile("assets/ninja.png"); err == nil {
+	if img, err := LoadImage(g.assets, "ninja.png"); err == nil {
 		g.playerImg = img
-	} else if img, _, err := ebitenutil.NewImageFromFile("assets/player.png"); err == nil {
+	} else if img, err := LoadImage(g.assets, "player.png"); err == nil {
 		g.playerImg = img
 	}
-	if img, _, err := ebitenutil.NewImageFromFile("assets/zombie.png"); err == nil {
+	if img, err := LoadImage(g.assets, "zombie.png"); err == nil {
 		g.zombieImg = img
-	} else if img, _, err := ebitenutil.NewImageFromFile("assets/enemy.png"); err == nil {
+	} else if img, err := LoadImage(g.assets, "enemy.png"); err == nil {
 		g.zombieImg = img
 	}
-	if img, _, err := ebitenutil.NewImageFromFile("assets/shuriken.png"); err == nil {
+	if img, err := LoadImage(g.assets, "bat.png"); err == nil {
+		g.batImg = img
+	}
+	if img, err := LoadImage(g.assets, "boss.png"); err == nil {
+		g.bossImg = img
+	}
+	if img, err := LoadImage(g.assets, "shuriken.png"); err == nil {
 		g.bulletImg = img
-	} else if img, _, err := ebitenutil.NewImageFromFile("assets/dagger.png"); err == nil {
+	} else if img, err := LoadImage(g.assets, "dagger.png"); err == nil {
 		g.bulletImg = img
 	}
 }
@@ -264,43 +599,140 @@ func (g *Game) initImages() {
 // === DAMAGE ===
 
 func (g *Game) loseLife() {
-	if g.inv > 0 || g.win || g.over {
+	if g.inv > 0 || g.scene != scenePlaying {
 		return
 	}
 	g.lives--
 	g.inv = iframeTicks
 	g.play(g.sHit)
+	g.emitParticles(g.px+playerSize/2, g.py+playerSize/2, hitParticles, hitSpeed, hitLife, color.RGBA{40, 40, 40, 255})
 	if g.lives < 0 {
-		g.over = true
+		g.endRun(sceneGameOver)
+	}
+}
+
+// === ENEMY AI ===
+
+// steerEnemy picks a new (vx, vy) for e based on distance to the player,
+// then queues the next time it should reconsider. Called when e.nextAction
+// runs out so behavior looks organic rather than deterministic.
+func (g *Game) steerEnemy(e *enemy) {
+	speed := enemySpeed(g.roundIdx)
+	if e.kind == TypeBat {
+		speed *= batSpeedMultiplier
+	}
+	minSpeed := speed * minSpeedFactor
+	maxSpeed := speed * maxSpeedFactor
+
+	if dist(e.x, e.y, g.px, g.py) <= seekDistance {
+		a := math.Atan2(g.py-e.y, g.px-e.x)
+		e.vx = math.Cos(a) * speed
+		e.vy = math.Sin(a) * speed
+		e.state = stateSeek
+	} else {
+		e.vx = (g.rng.Float64()*2 - 1) * driftJitter
+		e.vy = speed
+		e.state = stateDrift
+	}
+
+	e.vx = clampAbs(e.vx, 0, maxSpeed)
+	e.vy = clampAbs(e.vy, minSpeed, maxSpeed)
+	e.nextAction = nextActionMin + g.rng.Intn(nextActionJitter)
+}
+
+// damageEnemy applies one bullet hit to the enemy owning sh and reports
+// whether that hit killed it (health <= 0). Bosses soak several hits before
+// dead[e.sh] gets set by the caller.
+func (g *Game) damageEnemy(sh resolv.IShape) bool {
+	for i := range g.enemies {
+		if g.enemies[i].sh == sh {
+			g.enemies[i].health--
+			return g.enemies[i].health <= 0
+		}
+	}
+	return true
+}
+
+// === POWERUPS ===
+
+func (g *Game) applyPowerup(kind powerupKind) {
+	now := time.Now()
+	switch kind {
+	case PowerupGarlic:
+		g.repelUntil = now.Add(repelDuration)
+	case PowerupHolyWater:
+		g.freezeUntil = now.Add(freezeDuration)
+	}
+}
+
+// === PARTICLES ===
+
+// emitParticles spawns n particles at (x, y) with random directions at the
+// given speed, writing into a fixed-size ring buffer (maxParticles) so kills
+// and gunfire don't churn the GC every frame. Oldest particles are simply
+// overwritten once the buffer wraps, whether or not they'd finished fading.
+func (g *Game) emitParticles(x, y float64, n int, speed, life float64, col color.RGBA) {
+	for i := 0; i < n; i++ {
+		a := g.rng.Float64() * 2 * math.Pi
+		sp := speed * (0.5 + g.rng.Float64()*0.5)
+		g.particles[g.particleW] = particle{
+			x: x, y: y,
+			vx: math.Cos(a) * sp, vy: math.Sin(a) * sp,
+			life: life, maxLife: life,
+			col: col,
+		}
+		g.particleW = (g.particleW + 1) % len(g.particles)
+	}
+}
+
+// updateParticles advances position and counts down life for every slot in
+// the pool; expired slots (life <= 0) are left in place and simply skipped
+// until emitParticles overwrites them.
+func (g *Game) updateParticles() {
+	for i := range g.particles {
+		p := &g.particles[i]
+		if p.life <= 0 {
+			continue
+		}
+		p.x += p.vx
+		p.y += p.vy
+		p.life--
 	}
 }
 
 // === UPDATE (logic) ===
 
 func (g *Game) Update() error {
-	// stop logic after end state
-	if g.win || g.over {
-		return nil
+	switch g.scene {
+	case sceneTitle, sceneWin, sceneGameOver:
+		return g.updateEndOrTitle()
+	default:
+		return g.updatePlaying()
 	}
+}
 
+// updateEndOrTitle waits on the title/win/game-over screens for the player
+// to advance: Enter/Space restarts a fresh run and enters scenePlaying.
+func (g *Game) updateEndOrTitle() error {
+	if startPressed() {
+		g.resetRound()
+		g.scene = scenePlaying
+	}
+	return nil
+}
+
+func (g *Game) updatePlaying() error {
 	// background scroll accumulator (wrap happens in Draw)
 	if g.bgImg != nil {
 		g.bgOff += bgScrollSpeed
 	}
 
-	// input: arrows/WASD
-	if ebiten.IsKeyPressed(ebiten.KeyLeft) || ebiten.IsKeyPressed(ebiten.KeyA) {
-		g.px -= playerSpeed
-	}
-	if ebiten.IsKeyPressed(ebiten.KeyRight) || ebiten.IsKeyPressed(ebiten.KeyD) {
-		g.px += playerSpeed
-	}
-	if ebiten.IsKeyPressed(ebiten.KeyUp) || ebiten.IsKeyPressed(ebiten.KeyW) {
-		g.py -= playerSpeed
-	}
-	if ebiten.IsKeyPressed(ebiten.KeyDown) || ebiten.IsKeyPressed(ebiten.KeyS) {
-		g.py += playerSpeed
-	}
+	g.updateParticles()
+
+	// input: keyboard + gamepad (see input.go)
+	dx, dy := moveVec()
+	g.px += dx * playerSpeed
+	g.py += dy * playerSpeed
 
 	// clamp to screen
 	if g.px < 0 {
@@ -322,11 +754,11 @@ func (g *Game) Update() error {
 		g.inv--
 	}
 
-	// shooting (Space/J) with cooldown
+	// shooting (keyboard/gamepad, see input.go) with cooldown
 	if g.cooldown > 0 {
 		g.cooldown--
 	}
-	if (ebiten.IsKeyPressed(ebiten.KeySpace) || ebiten.IsKeyPressed(ebiten.KeyJ)) && g.cooldown == 0 {
+	if firePressed() && g.cooldown == 0 {
 		bx := g.px + playerSize/2 - bulletSize/2
 		by := g.py - bulletSize
 		sh := resolv.NewRectangleFromTopLeft(bx, by, bulletSize, bulletSize)
@@ -335,22 +767,39 @@ func (g *Game) Update() error {
 		g.bullets = append(g.bullets, bullet{x: bx, y: by, vy: bulletSpeed, sh: sh})
 		g.cooldown = fireDelay(g.roundIdx)
 		g.play(g.sShoot)
+		g.emitParticles(bx+bulletSize/2, by+bulletSize, muzzleParticles, muzzleSpeed, muzzleLife, color.RGBA{250, 230, 120, 255})
 	}
 
 	// enemy spawns
 	g.spawnTimer--
 	if g.spawnTimer <= 0 && g.roundIdx < len(rounds) {
-		if g.roundSpawned < rounds[g.roundIdx] {
+		if g.roundSpawned < len(g.roundSpawnList) {
 			ex := g.rng.Float64() * (screenW - enemySize)
-			sh := resolv.NewRectangleFromTopLeft(ex, -enemySize, enemySize, enemySize)
-			sh.Tags().Set(tagEnemy)
-			g.space.Add(sh)
-			g.enemies = append(g.enemies, enemy{x: ex, y: -enemySize, vy: enemySpeed(g.roundIdx), sh: sh})
+			e := newEnemy(g.roundSpawnList[g.roundSpawned], ex, -enemySize)
+			e.vy = enemySpeed(g.roundIdx)
+			e.nextAction = g.rng.Intn(nextActionMin)
+			g.space.Add(e.sh)
+			g.enemies = append(g.enemies, e)
 			g.roundSpawned++
 		}
 		g.spawnTimer = spawnInterval(g.roundIdx)
 	}
 
+	// powerup drops
+	g.powerupTimer--
+	if g.powerupTimer <= 0 {
+		px := g.rng.Float64() * (screenW - powerupSize)
+		kind := PowerupGarlic
+		if g.rng.Intn(2) == 1 {
+			kind = PowerupHolyWater
+		}
+		sh := resolv.NewRectangleFromTopLeft(px, -powerupSize, powerupSize, powerupSize)
+		sh.Tags().Set(tagPickup)
+		g.space.Add(sh)
+		g.powerups = append(g.powerups, powerup{x: px, y: -powerupSize, kind: kind, sh: sh})
+		g.powerupTimer = powerupSpawnInterval
+	}
+
 	// bullets move + collide
 	dead := make(map[resolv.IShape]bool)
 	bw := 0
@@ -363,8 +812,10 @@ func (g *Game) Update() error {
 		b.sh.IntersectionTest(resolv.IntersectionTestSettings{
 			TestAgainst: b.sh.SelectTouchingCells(0).FilterShapes().ByTags(tagEnemy),
 			OnIntersect: func(set resolv.IntersectionSet) bool {
-				dead[set.OtherShape] = true
 				hit = true
+				if g.damageEnemy(set.OtherShape) {
+					dead[set.OtherShape] = true
+				}
 				return false
 			},
 		})
@@ -375,19 +826,69 @@ func (g *Game) Update() error {
 		} else {
 			g.space.Remove(b.sh)
 			if hit {
-				g.roundKills++
-				g.totalKills++
 				g.play(g.sHit)
 			}
 		}
 	}
 	g.bullets = g.bullets[:bw]
 
+	// powerups move + collide with player
+	pw := 0
+	for i := 0; i < len(g.powerups); i++ {
+		p := g.powerups[i]
+		p.y += powerupSpeed
+		p.sh.SetPosition(p.x, p.y)
+
+		picked := false
+		g.playerSh.IntersectionTest(resolv.IntersectionTestSettings{
+			TestAgainst: g.playerSh.SelectTouchingCells(0).FilterShapes().ByTags(tagPickup),
+			OnIntersect: func(set resolv.IntersectionSet) bool {
+				if set.OtherShape == p.sh {
+					picked = true
+				}
+				return false
+			},
+		})
+
+		if picked {
+			g.applyPowerup(p.kind)
+			g.space.Remove(p.sh)
+			continue
+		}
+		if p.y > screenH {
+			g.space.Remove(p.sh)
+			continue
+		}
+
+		g.powerups[pw] = p
+		pw++
+	}
+	g.powerups = g.powerups[:pw]
+
 	// enemies move; player/escape checks
+	now := time.Now()
+	frozen := now.Before(g.freezeUntil)
+	repelling := now.Before(g.repelUntil)
 	ew := 0
 	for i := 0; i < len(g.enemies); i++ {
-		e := g.enemies[i]
-		e.y += e.vy
+		e := &g.enemies[i]
+		if !frozen {
+			e.nextAction--
+			if e.nextAction <= 0 {
+				g.steerEnemy(e)
+			}
+			e.age++
+
+			vx, vy := e.vx, e.vy
+			if e.kind == TypeBat {
+				vx += math.Sin(float64(e.age)*batSinFreq) * batSinAmplitude
+			}
+			if repelling && dist(e.x, e.y, g.px, g.py) <= repelRadius {
+				vx, vy = -vx, -vy
+			}
+			e.x += vx
+			e.y += vy
+		}
 		e.sh.SetPosition(e.x, e.y)
 
 		// enemy → player (only if not invincible)
@@ -405,6 +906,10 @@ func (g *Game) Update() error {
 		// remove if killed or escaped
 		if dead[e.sh] {
 			g.space.Remove(e.sh)
+			g.roundKills++
+			g.totalKills++
+			g.score += e.killScore
+			g.emitParticles(e.x+enemySize/2, e.y+enemySize/2, gibParticles, gibSpeed, gibLife, color.RGBA{200, 30, 30, 255})
 			continue
 		}
 		if e.y > screenH {
@@ -413,18 +918,21 @@ func (g *Game) Update() error {
 			continue
 		}
 
-		g.enemies[ew] = e
+		g.enemies[ew] = *e
 		ew++
 	}
 	g.enemies = g.enemies[:ew]
 
 	// round advance
-	if g.roundIdx < len(rounds) && g.roundKills >= rounds[g.roundIdx] {
+	if g.roundIdx < len(rounds) && g.roundKills >= len(g.roundSpawnList) {
+		g.score += roundClearBonus
 		g.roundIdx++
 		g.roundKills, g.roundSpawned = 0, 0
 		g.spawnTimer = spawnInterval(g.roundIdx)
 		if g.roundIdx >= len(rounds) {
-			g.win = true
+			g.endRun(sceneWin)
+		} else {
+			g.roundSpawnList = buildSpawnList(rounds[g.roundIdx])
 		}
 	}
 
@@ -434,6 +942,11 @@ func (g *Game) Update() error {
 // === DRAW (render) ===
 
 func (g *Game) Draw(screen *ebiten.Image) {
+	if g.scene == sceneTitle {
+		g.drawTitle(screen)
+		return
+	}
+
 	// background → COVER: scale to fill entire screen, tile vertically for scroll
 	if g.bgImg != nil {
 		bw := g.bgImg.Bounds().Dx()
@@ -481,35 +994,88 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		}
 	}
 
-	// enemies
+	// enemies (sprite picked per-kind, falls back to a kind-tinted rect)
 	for _, e := range g.enemies {
-		if g.zombieImg != nil {
-			w, h := g.zombieImg.Bounds().Dx(), g.zombieImg.Bounds().Dy()
+		img := g.zombieImg
+		col := color.RGBA{220, 60, 60, 255}
+		switch e.kind {
+		case TypeBat:
+			img, col = g.batImg, color.RGBA{150, 90, 200, 255}
+		case TypeBoss:
+			img, col = g.bossImg, color.RGBA{120, 30, 30, 255}
+		}
+
+		if img != nil {
+			w, h := img.Bounds().Dx(), img.Bounds().Dy()
 			op := &ebiten.DrawImageOptions{}
 			op.GeoM.Scale(enemySize/float64(w), enemySize/float64(h))
 			op.GeoM.Translate(e.x, e.y)
-			screen.DrawImage(g.zombieImg, op)
+			screen.DrawImage(img, op)
 		} else {
-			ebitenutil.DrawRect(screen, e.x, e.y, enemySize, enemySize, color.RGBA{220, 60, 60, 255})
+			ebitenutil.DrawRect(screen, e.x, e.y, enemySize, enemySize, col)
+		}
+	}
+
+	// powerups (garlic = green, holy water = blue)
+	for _, p := range g.powerups {
+		col := color.RGBA{80, 200, 90, 255}
+		if p.kind == PowerupHolyWater {
+			col = color.RGBA{90, 140, 220, 255}
 		}
+		ebitenutil.DrawRect(screen, p.x, p.y, powerupSize, powerupSize, col)
+	}
+
+	// particles (muzzle flash / blood spray / hit spark), alpha-faded by
+	// remaining life
+	for _, pt := range g.particles {
+		if pt.life <= 0 {
+			continue
+		}
+		col := pt.col
+		col.A = uint8(255 * pt.life / pt.maxLife)
+		ebitenutil.DrawRect(screen, pt.x, pt.y, 3, 3, col)
 	}
 
 	// end messages
-	if g.win {
-		drawCenterPanel(screen, "YOU WIN!", "")
+	if g.scene == sceneWin {
+		drawCenterPanel(screen, "YOU WIN!", g.scoreSummary())
 		return
 	}
-	if g.over {
-		drawCenterPanel(screen, "GAME OVER", "")
+	if g.scene == sceneGameOver {
+		drawCenterPanel(screen, "GAME OVER", g.scoreSummary())
 		return
 	}
 
 	// HUD
 	msg := fmt.Sprintf(
-		"Round: %d/6 | Kills: %d/%d\nLives: %d | FireDelay: %d | EnemySpd: %.2f",
+		"Round: %d/6 | Kills: %d/%d\nLives: %d | FireDelay: %d | EnemySpd: %.2f\nScore: %s",
 		g.roundIdx+1,
-		g.roundKills, rounds[g.roundIdx],
+		g.roundKills, len(g.roundSpawnList),
 		g.lives, fireDelay(g.roundIdx), enemySpeed(g.roundIdx),
+		g.msgPrinter.Sprintf("%d", g.score),
+	)
+	if left := time.Until(g.repelUntil); left > 0 {
+		msg += fmt.Sprintf("\nGarlic: %.1fs", left.Seconds())
+	}
+	if left := time.Until(g.freezeUntil); left > 0 {
+		msg += fmt.Sprintf("\nHoly Water: %.1fs", left.Seconds())
+	}
+	ebitenutil.DebugPrint(screen, msg)
+}
+
+// scoreSummary is shown on the win/game-over panels.
+func (g *Game) scoreSummary() string {
+	return fmt.Sprintf("Score: %s | Best: %s\nPress Enter to play again",
+		g.msgPrinter.Sprintf("%d", g.score), g.msgPrinter.Sprintf("%d", g.highScore))
+}
+
+// drawTitle shows controls and the best score, and waits for Enter/Space
+// (handled in updateEndOrTitle) to start a run.
+func (g *Game) drawTitle(screen *ebiten.Image) {
+	screen.Fill(color.RGBA{12, 12, 20, 255})
+	msg := fmt.Sprintf(
+		"TOP SCROLLER\n\nWASD/Arrows or left stick: Move\nSpace/J or A/Cross: Shoot\nEnter/Space or Start: Begin\n\nBest: %s",
+		g.msgPrinter.Sprintf("%d", g.highScore),
 	)
 	ebitenutil.DebugPrint(screen, msg)
 }
@@ -531,6 +1097,8 @@ func drawCenterPanel(screen *ebiten.Image, line1, line2 string) {
 // === ENTRY POINT ===
 
 func main() {
+	flag.Parse()
+
 	ebiten.SetWindowTitle("Top Scroller")
 	ebiten.SetWindowSize(screenW, screenH)
 	if err := ebiten.RunGame(newGame()); err != nil {